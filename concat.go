@@ -0,0 +1,101 @@
+package cstring
+
+import "errors"
+
+// Concat joins parts into a single null-terminated CString, allocating the
+// destination buffer exactly once. Panics if the concatenation overflows an
+// int or if any part contains a null character.
+func Concat[T ByteConstraint](parts ...string) CString[T] {
+	cStr, err := ConcatWithCheck[T](parts...)
+	if err != nil {
+		panic(err)
+	}
+	return cStr
+}
+
+// ConcatWithCheck joins parts into a single null-terminated CString,
+// allocating the destination buffer exactly once. Returns an error if any
+// part contains a null character.
+func ConcatWithCheck[T ByteConstraint](parts ...string) (CString[T], error) {
+	l := 0
+	for _, p := range parts {
+		n := l + len(p)
+		if n < l {
+			panic("cstring: concatenated string too long")
+		}
+		l = n
+	}
+	cStr := make(CString[T], l+1) // +1 for the null terminator
+	i := 0
+	for _, p := range parts {
+		for j := 0; j < len(p); j++ {
+			if p[j] == 0 {
+				return nil, errors.New("string contains null character")
+			}
+			cStr[i] = T(p[j])
+			i++
+		}
+	}
+	cStr[l] = 0 // null-terminate the string
+	return cStr, nil
+}
+
+// Builder incrementally assembles a null-terminated CString without
+// repeatedly reallocating the underlying buffer. The zero value is ready to
+// use.
+type Builder[T ByteConstraint] struct {
+	buf []T // does not include the null terminator
+}
+
+// Grow grows the builder's capacity, if necessary, to guarantee space for
+// another n bytes. After Grow(n), at least n bytes can be written to the
+// builder without another allocation.
+func (b *Builder[T]) Grow(n int) {
+	if n < 0 {
+		panic("cstring: negative count")
+	}
+	if cap(b.buf)-len(b.buf) >= n {
+		return
+	}
+	buf := make([]T, len(b.buf), 2*cap(b.buf)+n)
+	copy(buf, b.buf)
+	b.buf = buf
+}
+
+// WriteByte appends the byte c to the builder's buffer. Returns an error if c
+// is the null character, since a CString cannot contain an embedded null.
+func (b *Builder[T]) WriteByte(c byte) error {
+	if c == 0 {
+		return errors.New("string contains null character")
+	}
+	b.buf = append(b.buf, T(c))
+	return nil
+}
+
+// WriteString appends s to the builder's buffer. Returns an error if s
+// contains a null character, since a CString cannot contain an embedded null.
+func (b *Builder[T]) WriteString(s string) error {
+	for i := 0; i < len(s); i++ {
+		if s[i] == 0 {
+			return errors.New("string contains null character")
+		}
+	}
+	b.Grow(len(s))
+	for i := 0; i < len(s); i++ {
+		b.buf = append(b.buf, T(s[i]))
+	}
+	return nil
+}
+
+// Len returns the number of bytes written so far, not including the null
+// terminator.
+func (b *Builder[T]) Len() int { return len(b.buf) }
+
+// CString returns the null-terminated CString assembled so far. The returned
+// CString is a copy; the builder remains usable afterward.
+func (b *Builder[T]) CString() CString[T] {
+	cStr := make(CString[T], len(b.buf)+1)
+	copy(cStr, b.buf)
+	cStr[len(b.buf)] = 0 // null-terminate the string
+	return cStr
+}
@@ -0,0 +1,88 @@
+package cstring
+
+import "testing"
+
+func TestConcat(t *testing.T) {
+	cStr := Concat[byte]("hello", ", ", "world", "!")
+	want := "hello, world!"
+	if got := cStr.String(); got != want {
+		t.Errorf("expected %s, got %s", want, got)
+	}
+	if len(cStr) != len(want)+1 {
+		t.Errorf("expected length %d, got %d", len(want)+1, len(cStr))
+	}
+}
+
+func TestConcatEmpty(t *testing.T) {
+	cStr := Concat[byte]()
+	if got := cStr.String(); got != "" {
+		t.Errorf("expected empty string, got %s", got)
+	}
+	if len(cStr) != 1 {
+		t.Errorf("expected length 1, got %d", len(cStr))
+	}
+}
+
+func TestConcatWithCheckNullCharacter(t *testing.T) {
+	cStr, err := ConcatWithCheck[byte]("hello", "wor\x00ld")
+	if err == nil {
+		t.Errorf("expected error for string with null character")
+	}
+	if cStr != nil {
+		t.Errorf("expected nil CString")
+	}
+}
+
+func TestConcatPanicsOnNullCharacter(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("expected panic for string with null character")
+		}
+	}()
+	Concat[byte]("hello", "wor\x00ld")
+}
+
+func TestBuilder(t *testing.T) {
+	var b Builder[byte]
+	if err := b.WriteString("hello"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := b.WriteByte(' '); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := b.WriteString("world"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := "hello world"
+	if b.Len() != len(want) {
+		t.Errorf("expected length %d, got %d", len(want), b.Len())
+	}
+	cStr := b.CString()
+	if got := cStr.String(); got != want {
+		t.Errorf("expected %s, got %s", want, got)
+	}
+}
+
+func TestBuilderWriteStringNullCharacter(t *testing.T) {
+	var b Builder[byte]
+	if err := b.WriteString("hello\x00world"); err == nil {
+		t.Errorf("expected error for string with null character")
+	}
+	if b.Len() != 0 {
+		t.Errorf("expected no bytes written, got %d", b.Len())
+	}
+}
+
+func TestBuilderGrow(t *testing.T) {
+	var b Builder[byte]
+	b.Grow(64)
+	if cap(b.buf) < 64 {
+		t.Errorf("expected capacity at least 64, got %d", cap(b.buf))
+	}
+	if err := b.WriteString("hello"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if b.Len() != 5 {
+		t.Errorf("expected length 5, got %d", b.Len())
+	}
+}
@@ -4,8 +4,6 @@
 // slice, and memory management is handled by Go.
 package cstring
 
-// #include <string.h>
-import "C"
 import (
 	"errors"
 	"unsafe"
@@ -57,12 +55,6 @@ func NewWithCheck[T ByteConstraint](s string) (CString[T], error) {
 	return cStr, nil
 }
 
-// String returns the Go string representation of the CString by calling
-// C.GoString.
-func (s CString[T]) String() string {
-	return C.GoString((*C.char)(unsafe.Pointer(&s[0])))
-}
-
 // Bytes returns a slice containing the Go string representation of the CString,
 // but under the hood the underlying slice data is not copied.
 func (s CString[T]) Bytes() []byte {
@@ -79,26 +71,3 @@ func (s CString[T]) Bytes() []byte {
 func (s CString[T]) Pointer() *T {
 	return &s[0]
 }
-
-// The following functions are used only for testing, as _test.go files cannot
-// use cgo.
-
-// strlen returns the length of the string pointed to by s.
-func strlen(ptr unsafe.Pointer) int {
-	cs := (*C.char)(ptr)
-	return int(C.strlen(cs))
-}
-
-// cStringEquals returns true if the C string pointed to by h is equal
-// to the Go string s.
-func cStringEquals(ptr unsafe.Pointer, s string) bool {
-	cs := (*C.char)(ptr)
-	for i := 0; i < len(s); i++ {
-		if *cs != C.char(s[i]) {
-			return false
-		}
-		// Increment the pointer to the next byte
-		cs = (*C.char)(unsafe.Pointer(uintptr(unsafe.Pointer(cs)) + 1))
-	}
-	return *cs == 0
-}
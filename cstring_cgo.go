@@ -0,0 +1,36 @@
+//go:build cgo
+
+package cstring
+
+// #include <string.h>
+import "C"
+import "unsafe"
+
+// String returns the Go string representation of the CString by calling
+// C.GoString.
+func (s CString[T]) String() string {
+	return C.GoString((*C.char)(unsafe.Pointer(&s[0])))
+}
+
+// The following functions are used only for testing. They live here, rather
+// than in a _test.go file, because cgo is not permitted in test files.
+
+// strlen returns the length of the string pointed to by ptr.
+func strlen(ptr unsafe.Pointer) int {
+	cs := (*C.char)(ptr)
+	return int(C.strlen(cs))
+}
+
+// cStringEquals returns true if the C string pointed to by ptr is equal
+// to the Go string s.
+func cStringEquals(ptr unsafe.Pointer, s string) bool {
+	cs := (*C.char)(ptr)
+	for i := 0; i < len(s); i++ {
+		if *cs != C.char(s[i]) {
+			return false
+		}
+		// Increment the pointer to the next byte
+		cs = (*C.char)(unsafe.Pointer(uintptr(unsafe.Pointer(cs)) + 1))
+	}
+	return *cs == 0
+}
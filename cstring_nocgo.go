@@ -0,0 +1,75 @@
+//go:build !cgo
+
+package cstring
+
+import "unsafe"
+
+const wordSize = int(unsafe.Sizeof(uintptr(0)))
+
+// hasZeroByte reports whether any of the bytes packed into the machine word x
+// is zero. This is the classic bit trick used by the Go runtime to scan
+// strings a word at a time instead of one byte at a time.
+func hasZeroByte(x uintptr) bool {
+	const (
+		lowBits  = ^uintptr(0) / 0xff // 0x0101...01
+		highBits = lowBits * 0x80     // 0x8080...80
+	)
+	return (x-lowBits)&^x&highBits != 0
+}
+
+// String returns the Go string representation of the CString. Unlike the cgo
+// build, which defers to C.GoString, this locates the null terminator with a
+// pure-Go scan mirroring the Go runtime's findnull: bytes are checked one at
+// a time until the scan reaches a word-aligned address, then a full machine
+// word at a time via hasZeroByte, so long strings are still scanned quickly
+// without a C toolchain.
+func (s CString[T]) String() string {
+	if len(s) == 0 {
+		return ""
+	}
+	data := unsafe.Pointer(&s[0])
+	n, max := 0, len(s)
+	for ; n < max && (uintptr(data)+uintptr(n))%uintptr(wordSize) != 0; n++ {
+		if *(*byte)(unsafe.Add(data, n)) == 0 {
+			return unsafe.String((*byte)(data), n)
+		}
+	}
+	for ; n+wordSize <= max; n += wordSize {
+		if word := *(*uintptr)(unsafe.Add(data, n)); hasZeroByte(word) {
+			for i := 0; i < wordSize; i++ {
+				if *(*byte)(unsafe.Add(data, n+i)) == 0 {
+					return unsafe.String((*byte)(data), n+i)
+				}
+			}
+		}
+	}
+	for ; n < max; n++ {
+		if *(*byte)(unsafe.Add(data, n)) == 0 {
+			return unsafe.String((*byte)(data), n)
+		}
+	}
+	panic("cstring: missing null terminator")
+}
+
+// The following functions are used only for testing, as pure-Go equivalents
+// of the cgo helpers in cstring_cgo.go.
+
+// strlen returns the length of the string pointed to by ptr.
+func strlen(ptr unsafe.Pointer) int {
+	n := 0
+	for *(*byte)(unsafe.Add(ptr, n)) != 0 {
+		n++
+	}
+	return n
+}
+
+// cStringEquals returns true if the C string pointed to by ptr is equal
+// to the Go string s.
+func cStringEquals(ptr unsafe.Pointer, s string) bool {
+	for i := 0; i < len(s); i++ {
+		if *(*byte)(unsafe.Add(ptr, i)) != s[i] {
+			return false
+		}
+	}
+	return *(*byte)(unsafe.Add(ptr, len(s))) == 0
+}
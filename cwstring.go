@@ -0,0 +1,112 @@
+package cstring
+
+import (
+	"errors"
+	"unicode/utf16"
+	"unsafe"
+
+	"golang.org/x/exp/constraints"
+)
+
+// WideCharConstraint is a type constraint for the code unit of a wide,
+// null-terminated string, such as the uint16 wchar_t used by Win32 APIs or
+// the 4-byte wchar_t used on Linux.
+type WideCharConstraint interface{ ~uint16 | ~int16 | ~uint32 }
+
+// CWString is a buffer that can be converted to a Go string or used in C
+// functions that require a pointer to a null-terminated wide string, such as
+// a Win32 API taking a wchar_t*. Unlike CString, which stores UTF-8 bytes,
+// CWString stores UTF-16 code units (or, when T is a 4-byte type, UTF-32 code
+// points), and Go handles the memory allocation and freeing.
+type CWString[T WideCharConstraint] []T
+
+// MakeW creates a new CWString with the given length in code units. The
+// provided length includes the null terminator. Panics if the length is less
+// than 1.
+func MakeW[T WideCharConstraint, I constraints.Integer](n I) CWString[T] {
+	if n < 1 {
+		panic("length must be at least 1")
+	}
+	return make(CWString[T], n)
+}
+
+// NewW creates a new null-terminated CWString from the given Go string,
+// encoding it as UTF-16 when T is a 2-byte type and as UTF-32 when T is a
+// 4-byte type. Panics if the specified Go string contains a null character.
+func NewW[T WideCharConstraint](s string) CWString[T] {
+	cStr, err := NewWWithCheck[T](s)
+	if err != nil {
+		panic(err)
+	}
+	return cStr
+}
+
+// NewWWithCheck creates a new null-terminated CWString from the given Go
+// string. Returns an error if the specified Go string contains a null
+// character. When T is a 2-byte type, s is encoded as UTF-16, matching
+// wchar_t on Windows; when T is a 4-byte type, s is encoded as UTF-32 (one
+// element per Unicode scalar value), matching wchar_t on Linux.
+func NewWWithCheck[T WideCharConstraint](s string) (CWString[T], error) {
+	for i := 0; i < len(s); i++ {
+		if s[i] == 0 {
+			return nil, errors.New("string contains null character")
+		}
+	}
+	runes := []rune(s)
+	var cStr CWString[T]
+	if unsafe.Sizeof(T(0)) == 4 {
+		cStr = make(CWString[T], len(runes)+1) // +1 for the null terminator
+		for i, r := range runes {
+			cStr[i] = T(r)
+		}
+	} else {
+		units := utf16.Encode(runes)
+		cStr = make(CWString[T], len(units)+1) // +1 for the null terminator
+		for i, u := range units {
+			cStr[i] = T(u)
+		}
+	}
+	cStr[len(cStr)-1] = 0 // null-terminate the string
+	return cStr, nil
+}
+
+// String returns the Go string representation of the CWString, decoding the
+// code units preceding the null terminator. When T is a 2-byte type, those
+// code units are decoded as UTF-16; when T is a 4-byte type, each non-zero
+// element is treated as a single Unicode scalar value (UTF-32).
+func (s CWString[T]) String() string {
+	n := 0
+	for s[n] != 0 {
+		n++
+	}
+	if unsafe.Sizeof(s[0]) == 4 {
+		runes := make([]rune, n)
+		for i := 0; i < n; i++ {
+			runes[i] = rune(s[i])
+		}
+		return string(runes)
+	}
+	units := make([]uint16, n)
+	for i := 0; i < n; i++ {
+		units[i] = uint16(s[i])
+	}
+	return string(utf16.Decode(units))
+}
+
+// Bytes returns a slice containing the raw code units of the CWString, not
+// including the null terminator, reinterpreted as bytes. The underlying
+// slice data is not copied.
+func (s CWString[T]) Bytes() []byte {
+	if len(s) < 1 {
+		return nil
+	}
+	dataPtr := unsafe.Pointer(unsafe.SliceData(s))
+	return unsafe.Slice((*byte)(dataPtr), (len(s)-1)*int(unsafe.Sizeof(s[0])))
+}
+
+// Pointer returns the pointer to the first element of the CWString. This
+// function does not perform any conversions because the string is already
+// stored internally as a null-terminated wide string, so it is very fast.
+func (s CWString[T]) Pointer() *T {
+	return &s[0]
+}
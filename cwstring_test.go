@@ -0,0 +1,88 @@
+package cstring
+
+import (
+	"testing"
+	"unicode/utf16"
+)
+
+func TestMakeCWString(t *testing.T) {
+	length := 10
+	cStr := MakeW[uint16](length)
+	if len(cStr) != length {
+		t.Errorf("expected length %d, got %d", length, len(cStr))
+	}
+}
+
+func TestMakeCWStringZeroUnits(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("expected panic for length less than 1")
+		}
+	}()
+	MakeW[uint16](0)
+}
+
+func TestNewCWString(t *testing.T) {
+	goStr := "hello, world! 😊"
+	cStr := NewW[uint16](goStr)
+	if got := cStr.String(); got != goStr {
+		t.Errorf("expected %s, got %s", goStr, got)
+	}
+	// "😊" is encoded as a UTF-16 surrogate pair, so it takes two code units.
+	if want := len(utf16.Encode([]rune(goStr))) + 1; len(cStr) != want {
+		t.Errorf("expected length %d, got %d", want, len(cStr))
+	}
+}
+
+func TestNewCWStringWithNullCharacter(t *testing.T) {
+	cStr, err := NewWWithCheck[uint16]("hello\x00world")
+	if err == nil {
+		t.Errorf("expected error for string with null character")
+	}
+	if cStr != nil {
+		t.Errorf("expected nil CWString")
+	}
+}
+
+func TestNewCWStringWithNullCharacterPanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("expected panic for string with null character")
+		}
+	}()
+	NewW[uint16]("hello\x00world")
+}
+
+func TestCWStringBytes(t *testing.T) {
+	goStr := "hello"
+	cStr := NewW[uint16](goStr)
+	if got, want := len(cStr.Bytes()), (len(cStr)-1)*2; got != want {
+		t.Errorf("expected %d bytes, got %d", want, got)
+	}
+}
+
+func TestCWString4ByteWideChar(t *testing.T) {
+	goStr := "hello, world!"
+	cStr := NewW[uint32](goStr)
+	if got := cStr.String(); got != goStr {
+		t.Errorf("expected %s, got %s", goStr, got)
+	}
+}
+
+func TestCWString4ByteWideCharNonBMP(t *testing.T) {
+	// "😊" (U+1F60A) lies outside the basic multilingual plane, so a UTF-16
+	// encoding would split it into a surrogate pair. A true UTF-32 wchar_t
+	// must instead store it as a single element equal to its code point.
+	goStr := "hello 😊"
+	cStr := NewW[uint32](goStr)
+	runes := []rune(goStr)
+	if want := len(runes) + 1; len(cStr) != want {
+		t.Errorf("expected length %d, got %d", want, len(cStr))
+	}
+	if want := rune(0x1F60A); cStr[len(runes)-1] != uint32(want) {
+		t.Errorf("expected element %#x, got %#x", want, cStr[len(runes)-1])
+	}
+	if got := cStr.String(); got != goStr {
+		t.Errorf("expected %s, got %s", goStr, got)
+	}
+}
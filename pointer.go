@@ -0,0 +1,50 @@
+package cstring
+
+import "unsafe"
+
+// FromPointer scans the null-terminated string pointed to by p and returns a
+// copy owned by Go. Because the returned CString is a copy, the caller may
+// free the C-owned memory behind p immediately after this call returns.
+func FromPointer[T ByteConstraint](p *T) CString[T] {
+	n := scanForNull(p, -1)
+	cStr := make(CString[T], n+1)
+	copy(cStr, unsafe.Slice(p, n+1))
+	return cStr
+}
+
+// FromPointerN is like FromPointer, but the scan for the null terminator is
+// bounded to at most max bytes. Panics if no null terminator is found within
+// max bytes, guarding against runaway scans over a missing terminator.
+func FromPointerN[T ByteConstraint](p *T, max int) CString[T] {
+	if max < 0 {
+		panic("cstring: negative max")
+	}
+	n := scanForNull(p, max)
+	if n == max {
+		panic("cstring: missing null terminator within max bytes")
+	}
+	cStr := make(CString[T], n+1)
+	copy(cStr, unsafe.Slice(p, n+1))
+	return cStr
+}
+
+// Wrap returns a CString backed by the memory pointed to by p, without
+// copying. The caller must guarantee that the underlying C memory outlives
+// the returned CString and remains null-terminated; Wrap is intended for
+// zero-copy reads of C-owned memory, not for memory that Go should manage.
+func Wrap[T ByteConstraint](p *T) CString[T] {
+	n := scanForNull(p, -1)
+	return unsafe.Slice(p, n+1)
+}
+
+// scanForNull returns the number of bytes preceding the first null byte
+// pointed to by p. If max is non-negative, the scan stops after max bytes,
+// and scanForNull returns max if no null byte was found.
+func scanForNull[T ByteConstraint](p *T, max int) int {
+	base := unsafe.Pointer(p)
+	n := 0
+	for (max < 0 || n < max) && *(*byte)(unsafe.Add(base, n)) != 0 {
+		n++
+	}
+	return n
+}
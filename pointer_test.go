@@ -0,0 +1,48 @@
+package cstring
+
+import "testing"
+
+func TestFromPointer(t *testing.T) {
+	src := New[byte]("hello, world!")
+	cStr := FromPointer(src.Pointer())
+	if got, want := cStr.String(), src.String(); got != want {
+		t.Errorf("expected %s, got %s", want, got)
+	}
+	// FromPointer must return a copy, not an alias of src.
+	cStr[0] = 'H'
+	if src[0] == 'H' {
+		t.Errorf("expected FromPointer to copy, but mutation was observed in src")
+	}
+}
+
+func TestFromPointerN(t *testing.T) {
+	src := New[byte]("hello")
+	cStr := FromPointerN(src.Pointer(), 10)
+	if got, want := cStr.String(), "hello"; got != want {
+		t.Errorf("expected %s, got %s", want, got)
+	}
+}
+
+func TestFromPointerNMissingTerminator(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("expected panic for missing null terminator")
+		}
+	}()
+	src := New[byte]("hello")
+	// Only scan the non-null-terminated prefix of src.
+	FromPointerN(src.Pointer(), len(src)-1)
+}
+
+func TestWrap(t *testing.T) {
+	src := New[byte]("hello, world!")
+	cStr := Wrap(src.Pointer())
+	if got, want := cStr.String(), src.String(); got != want {
+		t.Errorf("expected %s, got %s", want, got)
+	}
+	// Wrap must not copy: mutating src should be visible through cStr.
+	src[0] = 'H'
+	if cStr[0] != 'H' {
+		t.Errorf("expected Wrap to alias src, but mutation was not observed")
+	}
+}
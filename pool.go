@@ -0,0 +1,106 @@
+package cstring
+
+import "sync"
+
+// minPoolClassSize is the smallest buffer size, in bytes, handed out by a
+// Pool, similar in spirit to the Go runtime's tmpStringBufSize.
+const minPoolClassSize = 32
+
+// numPoolClasses is the number of power-of-two size classes a Pool maintains.
+const numPoolClasses = 20
+
+// poolClass returns the size class whose buffers are most likely to fit n
+// bytes without a reallocation.
+func poolClass(n int) int {
+	class := 0
+	size := minPoolClassSize
+	for size < n && class < numPoolClasses-1 {
+		size *= 2
+		class++
+	}
+	return class
+}
+
+// Pool is a sync.Pool-backed source of reusable CString buffers, intended
+// for FFI-heavy hot paths (database drivers, GUI bindings, syscall wrappers)
+// that would otherwise call New thousands of times per second. Buffers are
+// rounded up to power-of-two size classes so that buffers released by Put
+// can be reused by later calls to Get for similarly-sized strings. The zero
+// value is ready to use.
+//
+// Internally, pooled buffers are held behind a *CString[T] rather than a
+// CString[T] value: a sync.Pool stores its contents in an interface{}, and
+// boxing a multi-word slice value into an interface allocates on every Put,
+// which would defeat the point of pooling. Boxing a pointer does not
+// allocate, so Get and Put recycle the *CString[T] wrappers themselves
+// through an auxiliary pool, leaving the common case free of allocations.
+type Pool[T ByteConstraint] struct {
+	classes  [numPoolClasses]sync.Pool
+	wrappers sync.Pool
+}
+
+// wrapper returns a *CString[T] to box a buffer in, reusing one returned by
+// a previous Put when available.
+func (p *Pool[T]) wrapper() *CString[T] {
+	if w, _ := p.wrappers.Get().(*CString[T]); w != nil {
+		return w
+	}
+	return new(CString[T])
+}
+
+// Get returns a CString containing a copy of s, null-terminated, backed by a
+// buffer drawn from the pool when one of a suitable size is available.
+// Panics if s contains a null character. The returned CString must be
+// returned to the pool with Put once it is no longer needed, or promoted to
+// a permanent CString with Escape.
+func (p *Pool[T]) Get(s string) CString[T] {
+	n := len(s) + 1 // +1 for the null terminator
+	class := poolClass(n)
+	var buf CString[T]
+	if w, _ := p.classes[class].Get().(*CString[T]); w != nil {
+		buf = *w
+		*w = nil
+		p.wrappers.Put(w) // recycle the now-empty wrapper
+	}
+	if cap(buf) < n {
+		size := minPoolClassSize << class
+		if size < n {
+			size = n
+		}
+		buf = make(CString[T], size)
+	}
+	buf = buf[:n]
+	for i := 0; i < len(s); i++ {
+		if s[i] == 0 {
+			panic("cstring: string contains null character")
+		}
+		buf[i] = T(s[i])
+	}
+	buf[n-1] = 0 // null-terminate the string
+	return buf
+}
+
+// Put returns cs's buffer to the pool for reuse. cs must not be used again
+// after calling Put; as a defensive measure against such reuse-after-Put
+// bugs, the first byte of the reclaimed buffer is zeroed, so any stale read
+// observes an empty string rather than cs's previous contents.
+func (p *Pool[T]) Put(cs CString[T]) {
+	if cap(cs) == 0 {
+		return
+	}
+	buf := cs[:cap(cs)]
+	buf[0] = 0
+	class := poolClass(cap(buf))
+	w := p.wrapper()
+	*w = buf
+	p.classes[class].Put(w)
+}
+
+// Escape returns a copy of cs that is safe to retain after cs has been (or
+// will be) returned to the pool with Put. Use Escape when a CString obtained
+// from Get must outlive the call site that produced it.
+func (p *Pool[T]) Escape(cs CString[T]) CString[T] {
+	cStr := make(CString[T], len(cs))
+	copy(cStr, cs)
+	return cStr
+}
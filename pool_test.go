@@ -0,0 +1,88 @@
+package cstring
+
+import "testing"
+
+func TestPoolGet(t *testing.T) {
+	var p Pool[byte]
+	goStr := "hello, world!"
+	cStr := p.Get(goStr)
+	if got := cStr.String(); got != goStr {
+		t.Errorf("expected %s, got %s", goStr, got)
+	}
+	if len(cStr) != len(goStr)+1 {
+		t.Errorf("expected length %d, got %d", len(goStr)+1, len(cStr))
+	}
+}
+
+func TestPoolGetNullCharacterPanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("expected panic for string with null character")
+		}
+	}()
+	var p Pool[byte]
+	p.Get("hello\x00world")
+}
+
+func TestPoolReuse(t *testing.T) {
+	var p Pool[byte]
+	first := p.Get("hello")
+	ptr := first.Pointer()
+	p.Put(first)
+
+	second := p.Get("world")
+	if second.Pointer() != ptr {
+		t.Errorf("expected Get to reuse the buffer released by Put")
+	}
+	if got, want := second.String(), "world"; got != want {
+		t.Errorf("expected %s, got %s", want, got)
+	}
+}
+
+func TestPoolEscape(t *testing.T) {
+	var p Pool[byte]
+	cStr := p.Get("hello")
+	escaped := p.Escape(cStr)
+	p.Put(cStr)
+
+	// Get again so the original buffer is likely reused and overwritten.
+	p.Put(p.Get("clobbered"))
+
+	if got, want := escaped.String(), "hello"; got != want {
+		t.Errorf("expected Escape to be unaffected by pool reuse, got %s, want %s", got, want)
+	}
+}
+
+func TestPoolAllocsLessThanNew(t *testing.T) {
+	var p Pool[byte]
+	s := "hello, world!"
+
+	// Warm up so the pool has a buffer and wrapper to recycle before
+	// measuring the steady-state allocation count.
+	p.Put(p.Get(s))
+
+	poolAllocs := testing.AllocsPerRun(1000, func() {
+		p.Put(p.Get(s))
+	})
+	newAllocs := testing.AllocsPerRun(1000, func() {
+		_ = New[byte](s)
+	})
+	if poolAllocs >= newAllocs {
+		t.Errorf("expected Pool to allocate less than New in steady state, got %v pool allocs vs %v new allocs", poolAllocs, newAllocs)
+	}
+}
+
+func BenchmarkNew(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		cStr := New[byte]("hello, world!")
+		_ = cStr
+	}
+}
+
+func BenchmarkPoolGet(b *testing.B) {
+	var p Pool[byte]
+	for i := 0; i < b.N; i++ {
+		cStr := p.Get("hello, world!")
+		p.Put(cStr)
+	}
+}